@@ -0,0 +1,100 @@
+package hongo
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Cursor streams the documents produced by AggregateStream, decoding each one
+// into a bson.M on demand instead of buffering the whole result set.
+type Cursor struct {
+	cursor *mongo.Cursor
+}
+
+// Next advances the cursor to the next document, returning false once the
+// cursor is exhausted or an error occurs (check Err to tell which).
+func (c *Cursor) Next(ctx context.Context) bool {
+	return c.cursor.Next(ctx)
+}
+
+// Decode unmarshals the current document into a bson.M.
+func (c *Cursor) Decode() (bson.M, error) {
+	var v bson.M
+	err := c.cursor.Decode(&v)
+	return v, err
+}
+
+// Err returns the last error encountered by the cursor, if any.
+func (c *Cursor) Err() error {
+	return c.cursor.Err()
+}
+
+// Close closes the cursor, releasing its resources.
+func (c *Cursor) Close(ctx context.Context) error {
+	return c.cursor.Close(ctx)
+}
+
+// Aggregate executes an aggregation pipeline and returns all resulting
+// documents.
+//
+// The pipeline parameter must be a JSON array of aggregation stages, e.g.
+// `[{"$match": {"status": "A"}}, {"$group": {"_id": "$cust_id"}}]`.
+//
+// The opts parameter can be used to specify options for the operation (see the
+// options.AggregateOptions documentation).
+//
+// For more information about the command, see https://docs.mongodb.com/manual/reference/command/aggregate/.
+func (c *Collection) Aggregate(ctx context.Context, pipeline string, opts ...*options.AggregateOptions) ([]bson.M, error) {
+	var p bson.A
+	if err := json.Unmarshal([]byte(pipeline), &p); err != nil {
+		return nil, err
+	}
+
+	opCtx := &OpContext{Documents: p, Options: opts}
+	err := c.run(ctx, OpAggregate, opCtx, func(opCtx *OpContext) error {
+		cursor, err := c.coll.Aggregate(opCtx.Context, opCtx.Documents, opts...)
+		if err != nil {
+			return err
+		}
+
+		var results []bson.M
+		if err := cursor.All(opCtx.Context, &results); err != nil {
+			return err
+		}
+
+		opCtx.Result = results
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resultAs[[]bson.M](opCtx), nil
+}
+
+// AggregateStream is like Aggregate but returns a Cursor over the results
+// instead of buffering them all in memory, for pipelines that may produce a
+// large number of documents.
+func (c *Collection) AggregateStream(ctx context.Context, pipeline string, opts ...*options.AggregateOptions) (*Cursor, error) {
+	var p bson.A
+	if err := json.Unmarshal([]byte(pipeline), &p); err != nil {
+		return nil, err
+	}
+
+	opCtx := &OpContext{Documents: p, Options: opts}
+	err := c.run(ctx, OpAggregateStream, opCtx, func(opCtx *OpContext) error {
+		cursor, err := c.coll.Aggregate(opCtx.Context, opCtx.Documents, opts...)
+		if err != nil {
+			return err
+		}
+		opCtx.Result = &Cursor{cursor: cursor}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resultAs[*Cursor](opCtx), nil
+}
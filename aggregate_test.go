@@ -0,0 +1,40 @@
+package hongo_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hotmall/hongo"
+)
+
+func TestAggregate(t *testing.T) {
+	skipIfNoMongo(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	pipeline := `[{"$match": {"borough": "Manhattan"}}, {"$limit": 1}]`
+	results, err := hongo.Use("test").Collection("neighborhoods").Aggregate(ctx, pipeline)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(results)
+}
+
+func TestPaginateFind(t *testing.T) {
+	skipIfNoMongo(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	coll := hongo.Use("test").Collection("neighborhoods")
+	results, nextToken, err := coll.PaginateFind(ctx, `{}`, `{"_id": 1}`, 10, "")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(results, nextToken)
+}
@@ -0,0 +1,138 @@
+package hongo
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	defaultMaxBatch      = 1000
+	defaultFlushInterval = time.Second
+)
+
+// BulkOption customizes a BulkInserter created by Collection.BulkInserter.
+type BulkOption func(*BulkInserter)
+
+// WithMaxBatch sets the number of buffered documents that triggers a flush.
+// The default is 1000.
+func WithMaxBatch(maxBatch int) BulkOption {
+	return func(bi *BulkInserter) {
+		bi.maxBatch = maxBatch
+	}
+}
+
+// WithFlushInterval sets how often a BulkInserter flushes its buffer even if
+// MaxBatch has not been reached. The default is 1s.
+func WithFlushInterval(interval time.Duration) BulkOption {
+	return func(bi *BulkInserter) {
+		bi.flushInterval = interval
+	}
+}
+
+// BulkInserter coalesces InsertOne-style calls into periodic InsertMany batches
+// to amortize round-trip latency for high-throughput ingestion. Each flush
+// runs through the owning Collection's middleware chain as an OpInsertMany
+// operation, the same as a direct call to Collection.InsertMany.
+type BulkInserter struct {
+	coll          *Collection
+	maxBatch      int
+	flushInterval time.Duration
+
+	mu            sync.Mutex
+	docs          []interface{}
+	resultHandler func(*mongo.InsertManyResult, error)
+
+	ticker  *time.Ticker
+	closeCh chan struct{}
+	doneCh  chan struct{}
+}
+
+// BulkInserter returns a BulkInserter bound to c that batches documents into
+// InsertMany calls, flushing whenever MaxBatch documents are buffered or
+// FlushInterval elapses, whichever comes first.
+func (c *Collection) BulkInserter(opts ...BulkOption) *BulkInserter {
+	bi := &BulkInserter{
+		coll:          c,
+		maxBatch:      defaultMaxBatch,
+		flushInterval: defaultFlushInterval,
+		closeCh:       make(chan struct{}),
+		doneCh:        make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(bi)
+	}
+
+	bi.ticker = time.NewTicker(bi.flushInterval)
+	go bi.loop()
+
+	return bi
+}
+
+// SetResultHandler sets the callback invoked with the result of each flush.
+func (bi *BulkInserter) SetResultHandler(handler func(*mongo.InsertManyResult, error)) {
+	bi.mu.Lock()
+	defer bi.mu.Unlock()
+	bi.resultHandler = handler
+}
+
+// Insert buffers doc for the next flush, triggering one immediately if
+// MaxBatch is reached.
+func (bi *BulkInserter) Insert(doc interface{}) {
+	bi.mu.Lock()
+	bi.docs = append(bi.docs, doc)
+	full := len(bi.docs) >= bi.maxBatch
+	bi.mu.Unlock()
+
+	if full {
+		bi.Flush()
+	}
+}
+
+// Flush inserts any buffered documents immediately, regardless of MaxBatch or
+// FlushInterval.
+func (bi *BulkInserter) Flush() {
+	bi.mu.Lock()
+	if len(bi.docs) == 0 {
+		bi.mu.Unlock()
+		return
+	}
+	docs := bi.docs
+	bi.docs = nil
+	handler := bi.resultHandler
+	bi.mu.Unlock()
+
+	opCtx := &OpContext{Documents: docs}
+	err := bi.coll.run(context.Background(), OpInsertMany, opCtx, func(opCtx *OpContext) error {
+		result, err := bi.coll.coll.InsertMany(opCtx.Context, opCtx.Documents)
+		opCtx.Result = result
+		return err
+	})
+	if handler != nil {
+		handler(resultAs[*mongo.InsertManyResult](opCtx), err)
+	}
+}
+
+// Close flushes any remaining documents and stops the background flush loop.
+// It blocks until the flush goroutine has exited.
+func (bi *BulkInserter) Close() {
+	close(bi.closeCh)
+	<-bi.doneCh
+}
+
+func (bi *BulkInserter) loop() {
+	defer close(bi.doneCh)
+	defer bi.ticker.Stop()
+
+	for {
+		select {
+		case <-bi.ticker.C:
+			bi.Flush()
+		case <-bi.closeCh:
+			bi.Flush()
+			return
+		}
+	}
+}
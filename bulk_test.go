@@ -0,0 +1,19 @@
+package hongo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hotmall/hongo"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestBulkInserter(t *testing.T) {
+	skipIfNoMongo(t)
+
+	coll := hongo.Use("test").Collection("bulk_test")
+	bi := coll.BulkInserter(hongo.WithMaxBatch(10), hongo.WithFlushInterval(100*time.Millisecond))
+	bi.SetResultHandler(func(result *mongo.InsertManyResult, err error) {})
+	bi.Insert(map[string]interface{}{"n": 1})
+	bi.Close()
+}
@@ -2,41 +2,229 @@ package hongo
 
 import (
 	"context"
-	"fmt"
+	"crypto/tls"
+	"errors"
+	"sync"
 	"time"
 
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
 )
 
-type Options struct {
-	url string
-}
+// DefaultClientName is the name Connect and MustConnect register a client
+// under when no WithName option is given, and the name Use selects when no
+// WithClient option is given.
+const DefaultClientName = "default"
+
+// ErrNotConnected is the value Use panics with, and the error WithTransaction
+// returns, when no client has been registered under the requested name. Call
+// Connect, MustConnect, Register or Inject first.
+var ErrNotConnected = errors.New("hongo: not connected, call Connect/MustConnect/Register first")
 
 var (
-	client *mongo.Client
+	clientsMu sync.RWMutex
+	clients   = make(map[string]*mongo.Client)
 )
 
-func init() {
-	fmt.Println("start init")
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+// connectConfig collects the settings used to build a *mongo.Client in Connect.
+type connectConfig struct {
+	name           string
+	uri            string
+	connectTimeout time.Duration
+	pingTimeout    time.Duration
+	auth           *options.Credential
+	tlsConfig      *tls.Config
+	readConcern    *readconcern.ReadConcern
+	writeConcern   *writeconcern.WriteConcern
+	maxPoolSize    uint64
+}
+
+// Option configures a client created by Connect or MustConnect.
+type Option func(*connectConfig)
+
+// WithName registers the client under name instead of DefaultClientName, so
+// it can later be selected via WithClient.
+func WithName(name string) Option {
+	return func(c *connectConfig) {
+		c.name = name
+	}
+}
+
+// WithURI sets the connection URI. The default is mongodb://localhost:27017.
+func WithURI(uri string) Option {
+	return func(c *connectConfig) {
+		c.uri = uri
+	}
+}
+
+// WithConnectTimeout bounds how long Connect waits for mongo.Connect. The
+// default is 10s.
+func WithConnectTimeout(d time.Duration) Option {
+	return func(c *connectConfig) {
+		c.connectTimeout = d
+	}
+}
+
+// WithPingTimeout bounds how long Connect waits for the post-connect ping. The
+// default is 10s.
+func WithPingTimeout(d time.Duration) Option {
+	return func(c *connectConfig) {
+		c.pingTimeout = d
+	}
+}
 
-	opts := Options{
-		url: "mongodb://localhost:27017",
+// WithAuth sets the credential used to authenticate the client.
+func WithAuth(cred options.Credential) Option {
+	return func(c *connectConfig) {
+		c.auth = &cred
+	}
+}
+
+// WithTLSConfig sets the TLS config used to secure the connection.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(c *connectConfig) {
+		c.tlsConfig = cfg
+	}
+}
+
+// WithReadConcern sets the client's default read concern.
+func WithReadConcern(rc *readconcern.ReadConcern) Option {
+	return func(c *connectConfig) {
+		c.readConcern = rc
+	}
+}
+
+// WithWriteConcern sets the client's default write concern.
+func WithWriteConcern(wc *writeconcern.WriteConcern) Option {
+	return func(c *connectConfig) {
+		c.writeConcern = wc
+	}
+}
+
+// WithMaxPoolSize sets the maximum number of connections the client's pool
+// will hold.
+func WithMaxPoolSize(n uint64) Option {
+	return func(c *connectConfig) {
+		c.maxPoolSize = n
+	}
+}
+
+// Connect builds a *mongo.Client from opts, pings it to verify connectivity,
+// and registers it (see Register) so Use can find it by name.
+func Connect(ctx context.Context, opts ...Option) error {
+	cfg := &connectConfig{
+		name:           DefaultClientName,
+		uri:            "mongodb://localhost:27017",
+		connectTimeout: 10 * time.Second,
+		pingTimeout:    10 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(cfg)
 	}
-	var err error
-	client, err = mongo.Connect(ctx, options.Client().ApplyURI(opts.url))
+
+	clientOpts := options.Client().ApplyURI(cfg.uri)
+	if cfg.auth != nil {
+		clientOpts.SetAuth(*cfg.auth)
+	}
+	if cfg.tlsConfig != nil {
+		clientOpts.SetTLSConfig(cfg.tlsConfig)
+	}
+	if cfg.readConcern != nil {
+		clientOpts.SetReadConcern(cfg.readConcern)
+	}
+	if cfg.writeConcern != nil {
+		clientOpts.SetWriteConcern(cfg.writeConcern)
+	}
+	if cfg.maxPoolSize > 0 {
+		clientOpts.SetMaxPoolSize(cfg.maxPoolSize)
+	}
+
+	connectCtx, cancel := context.WithTimeout(ctx, cfg.connectTimeout)
+	defer cancel()
+
+	client, err := mongo.Connect(connectCtx, clientOpts)
 	if err != nil {
-		panic(err)
+		return err
+	}
+
+	pingCtx, cancel := context.WithTimeout(ctx, cfg.pingTimeout)
+	defer cancel()
+	if err := client.Ping(pingCtx, readpref.Primary()); err != nil {
+		return err
 	}
 
-	if err = client.Ping(ctx, readpref.Primary()); err != nil {
+	Register(cfg.name, client)
+	return nil
+}
+
+// MustConnect is like Connect but panics if the client cannot be connected to
+// or pinged.
+func MustConnect(ctx context.Context, opts ...Option) {
+	if err := Connect(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
-func Use(name string, opts ...*options.DatabaseOptions) *Database {
-	return newDatabase(name, opts...)
+// Register associates an already-constructed *mongo.Client with name so it can
+// later be selected via WithClient. It is mainly useful for sharing a client
+// that was built outside of Connect.
+func Register(name string, client *mongo.Client) {
+	clientsMu.Lock()
+	defer clientsMu.Unlock()
+	clients[name] = client
+}
+
+// Inject registers client under name, overriding any client already
+// registered there. It is intended for tests that need to swap in an mtest
+// client in place of a real connection.
+func Inject(name string, client *mongo.Client) {
+	Register(name, client)
+}
+
+// useConfig collects the settings used to resolve a *Database in Use.
+type useConfig struct {
+	clientName string
+	dbOpts     []*options.DatabaseOptions
+}
+
+// UseOption configures the database selection made by Use.
+type UseOption func(*useConfig)
+
+// WithClient selects the client registered under name (via Connect, MustConnect,
+// Register or Inject) for Use to operate against. The default is
+// DefaultClientName.
+func WithClient(name string) UseOption {
+	return func(c *useConfig) {
+		c.clientName = name
+	}
+}
+
+// WithDatabaseOptions passes options through to the underlying
+// mongo.Client.Database call.
+func WithDatabaseOptions(opts ...*options.DatabaseOptions) UseOption {
+	return func(c *useConfig) {
+		c.dbOpts = append(c.dbOpts, opts...)
+	}
+}
+
+// Use returns a Database for name using the client selected by opts, the
+// DefaultClientName client by default. It panics with ErrNotConnected if no
+// matching client has been registered.
+func Use(name string, opts ...UseOption) *Database {
+	cfg := &useConfig{clientName: DefaultClientName}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	clientsMu.RLock()
+	client, ok := clients[cfg.clientName]
+	clientsMu.RUnlock()
+	if !ok {
+		panic(ErrNotConnected)
+	}
+
+	return newDatabase(client, name, cfg.dbOpts...)
 }
@@ -1,13 +1,46 @@
 package hongo_test
 
 import (
+	"context"
 	"fmt"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/hotmall/hongo"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// mongoAvailable records whether TestMain managed to connect to a mongod,
+// letting individual tests skip instead of panicking through hongo.Use when
+// none is reachable (e.g. in CI or this package's sandbox).
+var mongoAvailable bool
+
+func TestMain(m *testing.M) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	mongoAvailable = hongo.Connect(ctx) == nil
+	cancel()
+	os.Exit(m.Run())
+}
+
+// skipIfNoMongo skips t when TestMain couldn't reach a mongod, so the rest of
+// the package's tests still run instead of panicking on hongo.Use.
+func skipIfNoMongo(t *testing.T) {
+	if !mongoAvailable {
+		t.Skip("no mongod reachable on mongodb://localhost:27017, skipping")
+	}
+}
+
 func TestUse(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := hongo.Connect(ctx); err != nil {
+		fmt.Println(err)
+		return
+	}
+
 	db := hongo.Use("test")
 	if db == nil {
 		fmt.Println("nil")
@@ -15,3 +48,21 @@ func TestUse(t *testing.T) {
 		fmt.Println("not nil")
 	}
 }
+
+// TestInject demonstrates the testability Inject exists for: swapping in a
+// client (here a plain driver client, in real tests an mtest one) under a
+// name of our choosing, with no dependency on the package's default client
+// or a reachable mongod.
+func TestInject(t *testing.T) {
+	client, err := mongo.Connect(context.Background(), options.Client().ApplyURI("mongodb://localhost:27017"))
+	if err != nil {
+		t.Fatalf("mongo.Connect: %v", err)
+	}
+
+	hongo.Inject("injected", client)
+
+	db := hongo.Use("test", hongo.WithClient("injected"))
+	if db == nil {
+		t.Fatal("Use returned nil after Inject")
+	}
+}
@@ -14,6 +14,7 @@ var ErrNilCollection = errors.New("collection is nil")
 
 type Collection struct {
 	coll *mongo.Collection
+	mw   []Middleware
 }
 
 func newCollection(db *mongo.Database, name string, opts ...*options.CollectionOptions) *Collection {
@@ -27,7 +28,17 @@ func (c *Collection) CountDocuments(ctx context.Context, filter string, opts ...
 	if err := json.Unmarshal([]byte(filter), &f); err != nil {
 		return 0, err
 	}
-	return c.coll.CountDocuments(ctx, f, opts...)
+
+	opCtx := &OpContext{Filter: f, Options: opts}
+	err := c.run(ctx, OpCountDocuments, opCtx, func(opCtx *OpContext) error {
+		count, err := c.coll.CountDocuments(opCtx.Context, opCtx.Filter, opts...)
+		opCtx.Result = count
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resultAs[int64](opCtx), nil
 }
 
 func (c *Collection) DeleteMany(ctx context.Context, filter string, opts ...*options.DeleteOptions) (*mongo.DeleteResult, error) {
@@ -35,7 +46,17 @@ func (c *Collection) DeleteMany(ctx context.Context, filter string, opts ...*opt
 	if err := json.Unmarshal([]byte(filter), &f); err != nil {
 		return nil, err
 	}
-	return c.coll.DeleteMany(ctx, f, opts...)
+
+	opCtx := &OpContext{Filter: f, Options: opts}
+	err := c.run(ctx, OpDeleteMany, opCtx, func(opCtx *OpContext) error {
+		result, err := c.coll.DeleteMany(opCtx.Context, opCtx.Filter, opts...)
+		opCtx.Result = result
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resultAs[*mongo.DeleteResult](opCtx), nil
 }
 
 func (c *Collection) DeleteOne(ctx context.Context, filter string, opts ...*options.DeleteOptions) (*mongo.DeleteResult, error) {
@@ -43,7 +64,17 @@ func (c *Collection) DeleteOne(ctx context.Context, filter string, opts ...*opti
 	if err := json.Unmarshal([]byte(filter), &f); err != nil {
 		return nil, err
 	}
-	return c.coll.DeleteOne(ctx, f, opts...)
+
+	opCtx := &OpContext{Filter: f, Options: opts}
+	err := c.run(ctx, OpDeleteOne, opCtx, func(opCtx *OpContext) error {
+		result, err := c.coll.DeleteOne(opCtx.Context, opCtx.Filter, opts...)
+		opCtx.Result = result
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resultAs[*mongo.DeleteResult](opCtx), nil
 }
 
 // Distinct executes a distinct command to find the unique values for a specified field in the collection.
@@ -63,15 +94,37 @@ func (c *Collection) Distinct(ctx context.Context, fieldName string, filter stri
 	if err := json.Unmarshal([]byte(filter), &f); err != nil {
 		return nil, err
 	}
-	return c.coll.Distinct(ctx, fieldName, f, opts...)
+
+	opCtx := &OpContext{Filter: f, Options: opts}
+	err := c.run(ctx, OpDistinct, opCtx, func(opCtx *OpContext) error {
+		result, err := c.coll.Distinct(opCtx.Context, fieldName, opCtx.Filter, opts...)
+		opCtx.Result = result
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resultAs[[]interface{}](opCtx), nil
 }
 
 func (c *Collection) Drop(ctx context.Context) error {
-	return c.coll.Drop(ctx)
+	opCtx := &OpContext{}
+	return c.run(ctx, OpDrop, opCtx, func(opCtx *OpContext) error {
+		return c.coll.Drop(opCtx.Context)
+	})
 }
 
 func (c *Collection) EstimatedDocumentCount(ctx context.Context, opts ...*options.EstimatedDocumentCountOptions) (int64, error) {
-	return c.coll.EstimatedDocumentCount(ctx, opts...)
+	opCtx := &OpContext{Options: opts}
+	err := c.run(ctx, OpEstimatedDocumentCount, opCtx, func(opCtx *OpContext) error {
+		count, err := c.coll.EstimatedDocumentCount(opCtx.Context, opts...)
+		opCtx.Result = count
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resultAs[int64](opCtx), nil
 }
 
 // Find executes a find command and returns a Cursor over the matching documents in the collection.
@@ -87,17 +140,26 @@ func (c *Collection) Find(ctx context.Context, filter string, opts ...*options.F
 	if err := json.Unmarshal([]byte(filter), &f); err != nil {
 		return nil, err
 	}
-	cursor, err := c.coll.Find(ctx, f, opts...)
-	if err != nil {
-		return nil, err
-	}
 
-	var results []bson.M
-	if err := cursor.All(ctx, &results); err != nil {
+	opCtx := &OpContext{Filter: f, Options: opts}
+	err := c.run(ctx, OpFind, opCtx, func(opCtx *OpContext) error {
+		cursor, err := c.coll.Find(opCtx.Context, opCtx.Filter, opts...)
+		if err != nil {
+			return err
+		}
+
+		var results []bson.M
+		if err := cursor.All(opCtx.Context, &results); err != nil {
+			return err
+		}
+
+		opCtx.Result = results
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
-
-	return results, nil
+	return resultAs[[]bson.M](opCtx), nil
 }
 
 // FindOne executes a find command and returns a SingleResult for one document in the collection.
@@ -119,10 +181,15 @@ func (c *Collection) FindOne(ctx context.Context, filter string, opts ...*option
 		return nil, err
 	}
 
-	result := c.coll.FindOne(ctx, f, opts...)
-	v := make(map[string]interface{})
-	err := result.Decode(v)
-	return v, err
+	opCtx := &OpContext{Filter: f, Options: opts}
+	err := c.run(ctx, OpFindOne, opCtx, func(opCtx *OpContext) error {
+		result := c.coll.FindOne(opCtx.Context, opCtx.Filter, opts...)
+		v := make(map[string]interface{})
+		err := result.Decode(v)
+		opCtx.Result = v
+		return err
+	})
+	return resultAs[map[string]interface{}](opCtx), err
 }
 
 // FindOneAndDelete executes a findAndModify command to delete at most one document in the collection. and returns the
@@ -143,10 +210,15 @@ func (c *Collection) FindOneAndDelete(ctx context.Context, filter string,
 		return nil, err
 	}
 
-	result := c.coll.FindOneAndDelete(ctx, f, opts...)
-	v := make(map[string]interface{})
-	err := result.Decode(v)
-	return v, err
+	opCtx := &OpContext{Filter: f, Options: opts}
+	err := c.run(ctx, OpFindOneAndDelete, opCtx, func(opCtx *OpContext) error {
+		result := c.coll.FindOneAndDelete(opCtx.Context, opCtx.Filter, opts...)
+		v := make(map[string]interface{})
+		err := result.Decode(v)
+		opCtx.Result = v
+		return err
+	})
+	return resultAs[map[string]interface{}](opCtx), err
 }
 
 // FindOneAndReplace executes a findAndModify command to replace at most one document in the collection
@@ -174,10 +246,16 @@ func (c *Collection) FindOneAndReplace(ctx context.Context, filter string, repla
 	if err := json.Unmarshal([]byte(replacement), &r); err != nil {
 		return nil, err
 	}
-	result := c.coll.FindOneAndReplace(ctx, f, r, opts...)
-	v := make(map[string]interface{})
-	err := result.Decode(v)
-	return v, err
+
+	opCtx := &OpContext{Filter: f, Update: r, Options: opts}
+	err := c.run(ctx, OpFindOneAndReplace, opCtx, func(opCtx *OpContext) error {
+		result := c.coll.FindOneAndReplace(opCtx.Context, opCtx.Filter, opCtx.Update, opts...)
+		v := make(map[string]interface{})
+		err := result.Decode(v)
+		opCtx.Result = v
+		return err
+	})
+	return resultAs[map[string]interface{}](opCtx), err
 }
 
 // FindOneAndUpdate executes a findAndModify command to update at most one document in the collection and returns the
@@ -206,15 +284,17 @@ func (c *Collection) FindOneAndUpdate(ctx context.Context, filter string, update
 	if err := json.Unmarshal([]byte(update), &u); err != nil {
 		return nil, err
 	}
-	result := c.coll.FindOneAndUpdate(ctx, f, u, opts...)
-	v := make(map[string]interface{})
-	err := result.Decode(v)
-	return v, err
-}
 
-// func (c *Collection) Indexes() IndexView {
-// 	return nil
-// }
+	opCtx := &OpContext{Filter: f, Update: u, Options: opts}
+	err := c.run(ctx, OpFindOneAndUpdate, opCtx, func(opCtx *OpContext) error {
+		result := c.coll.FindOneAndUpdate(opCtx.Context, opCtx.Filter, opCtx.Update, opts...)
+		v := make(map[string]interface{})
+		err := result.Decode(v)
+		opCtx.Result = v
+		return err
+	})
+	return resultAs[map[string]interface{}](opCtx), err
+}
 
 func (c *Collection) InsertMany(ctx context.Context, documents string,
 	opts ...*options.InsertManyOptions) (*mongo.InsertManyResult, error) {
@@ -222,7 +302,17 @@ func (c *Collection) InsertMany(ctx context.Context, documents string,
 	if err := json.Unmarshal([]byte(documents), &a); err != nil {
 		return nil, err
 	}
-	return c.coll.InsertMany(ctx, a, opts...)
+
+	opCtx := &OpContext{Documents: a, Options: opts}
+	err := c.run(ctx, OpInsertMany, opCtx, func(opCtx *OpContext) error {
+		result, err := c.coll.InsertMany(opCtx.Context, opCtx.Documents, opts...)
+		opCtx.Result = result
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resultAs[*mongo.InsertManyResult](opCtx), nil
 }
 
 func (c *Collection) InsertOne(ctx context.Context, document string,
@@ -231,7 +321,17 @@ func (c *Collection) InsertOne(ctx context.Context, document string,
 	if err := json.Unmarshal([]byte(document), &d); err != nil {
 		return nil, err
 	}
-	return c.coll.InsertOne(ctx, d, opts...)
+
+	opCtx := &OpContext{Documents: []interface{}{d}, Options: opts}
+	err := c.run(ctx, OpInsertOne, opCtx, func(opCtx *OpContext) error {
+		result, err := c.coll.InsertOne(opCtx.Context, opCtx.Documents[0], opts...)
+		opCtx.Result = result
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resultAs[*mongo.InsertOneResult](opCtx), nil
 }
 
 func (c *Collection) Name() string {
@@ -264,7 +364,16 @@ func (c *Collection) ReplaceOne(ctx context.Context, filter string, replacement
 		return nil, err
 	}
 
-	return c.coll.ReplaceOne(ctx, f, r, opts...)
+	opCtx := &OpContext{Filter: f, Update: r, Options: opts}
+	err := c.run(ctx, OpReplaceOne, opCtx, func(opCtx *OpContext) error {
+		result, err := c.coll.ReplaceOne(opCtx.Context, opCtx.Filter, opCtx.Update, opts...)
+		opCtx.Result = result
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resultAs[*mongo.UpdateResult](opCtx), nil
 }
 
 // UpdateMany executes an update command to update documents in the collection.
@@ -292,7 +401,16 @@ func (c *Collection) UpdateMany(ctx context.Context, filter string, update strin
 		return nil, err
 	}
 
-	return c.coll.UpdateMany(ctx, f, u, opts...)
+	opCtx := &OpContext{Filter: f, Update: u, Options: opts}
+	err := c.run(ctx, OpUpdateMany, opCtx, func(opCtx *OpContext) error {
+		result, err := c.coll.UpdateMany(opCtx.Context, opCtx.Filter, opCtx.Update, opts...)
+		opCtx.Result = result
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resultAs[*mongo.UpdateResult](opCtx), nil
 }
 
 // UpdateOne executes an update command to update at most one document in the collection.
@@ -321,7 +439,17 @@ func (c *Collection) UpdateOne(ctx context.Context, filter string, update string
 	if err := json.Unmarshal([]byte(update), &u); err != nil {
 		return nil, err
 	}
-	return c.coll.UpdateOne(ctx, f, u, opts...)
+
+	opCtx := &OpContext{Filter: f, Update: u, Options: opts}
+	err := c.run(ctx, OpUpdateOne, opCtx, func(opCtx *OpContext) error {
+		result, err := c.coll.UpdateOne(opCtx.Context, opCtx.Filter, opCtx.Update, opts...)
+		opCtx.Result = result
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resultAs[*mongo.UpdateResult](opCtx), nil
 }
 
 // UpdateByID executes an update command to update the document whose _id value matches the provided ID in the collection.
@@ -348,5 +476,14 @@ func (c *Collection) UpdateByID(ctx context.Context, id interface{}, update stri
 		return nil, err
 	}
 
-	return c.coll.UpdateOne(ctx, bson.D{{"_id", id}}, u, opts...)
+	opCtx := &OpContext{Filter: bson.M{"_id": id}, Update: u, Options: opts}
+	err := c.run(ctx, OpUpdateByID, opCtx, func(opCtx *OpContext) error {
+		result, err := c.coll.UpdateOne(opCtx.Context, opCtx.Filter, opCtx.Update, opts...)
+		opCtx.Result = result
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resultAs[*mongo.UpdateResult](opCtx), nil
 }
@@ -10,6 +10,8 @@ import (
 )
 
 func TestFindOne(t *testing.T) {
+	skipIfNoMongo(t)
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
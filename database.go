@@ -16,7 +16,7 @@ type Database struct {
 	db *mongo.Database
 }
 
-func newDatabase(name string, opts ...*options.DatabaseOptions) *Database {
+func newDatabase(client *mongo.Client, name string, opts ...*options.DatabaseOptions) *Database {
 	return &Database{
 		db: client.Database(name, opts...),
 	}
@@ -0,0 +1,201 @@
+package hongo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// IndexModel describes an index to create via IndexView.CreateOne,
+// IndexView.CreateMany or IndexView.EnsureIndexes.
+type IndexModel struct {
+	// Name overrides MongoDB's generated index name. EnsureIndexes uses this
+	// (falling back to the generated name when empty) to match models against
+	// existing indexes.
+	Name string
+
+	// Keys is a JSON document describing the indexed fields, consistent with
+	// the rest of hongo's JSON-first filter style, e.g. `{"email": 1}` for an
+	// ascending index, `{"loc": "2dsphere"}` for a geospatial index, or
+	// `{"a": 1, "b": -1}` for a compound index. Field order is significant for
+	// compound indexes and is preserved.
+	Keys string
+
+	Unique                  bool
+	Sparse                  bool
+	ExpireAfterSeconds      *int32
+	PartialFilterExpression string
+	Collation               *options.Collation
+}
+
+func (m IndexModel) keys() (bson.D, error) {
+	var keys bson.D
+	if err := bson.UnmarshalExtJSON([]byte(m.Keys), true, &keys); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (m IndexModel) toDriverModel() (mongo.IndexModel, error) {
+	keys, err := m.keys()
+	if err != nil {
+		return mongo.IndexModel{}, err
+	}
+
+	idxOpts := options.Index()
+	if m.Name != "" {
+		idxOpts.SetName(m.Name)
+	}
+	if m.Unique {
+		idxOpts.SetUnique(true)
+	}
+	if m.Sparse {
+		idxOpts.SetSparse(true)
+	}
+	if m.ExpireAfterSeconds != nil {
+		idxOpts.SetExpireAfterSeconds(*m.ExpireAfterSeconds)
+	}
+	if m.PartialFilterExpression != "" {
+		var pfe bson.M
+		if err := json.Unmarshal([]byte(m.PartialFilterExpression), &pfe); err != nil {
+			return mongo.IndexModel{}, err
+		}
+		idxOpts.SetPartialFilterExpression(pfe)
+	}
+	if m.Collation != nil {
+		idxOpts.SetCollation(m.Collation)
+	}
+
+	return mongo.IndexModel{Keys: keys, Options: idxOpts}, nil
+}
+
+// name returns m.Name, or MongoDB's default generated name for m.Keys if
+// m.Name is empty.
+func (m IndexModel) name() (string, error) {
+	if m.Name != "" {
+		return m.Name, nil
+	}
+
+	keys, err := m.keys()
+	if err != nil {
+		return "", err
+	}
+	return generatedIndexName(keys), nil
+}
+
+// generatedIndexName reproduces MongoDB's default index naming convention of
+// joining each field and its order/type with underscores, e.g. {"email": 1}
+// becomes "email_1" and {"loc": "2dsphere"} becomes "loc_2dsphere".
+func generatedIndexName(keys bson.D) string {
+	parts := make([]string, 0, len(keys)*2)
+	for _, e := range keys {
+		parts = append(parts, e.Key, fmt.Sprint(e.Value))
+	}
+	return strings.Join(parts, "_")
+}
+
+// IndexView manages the indexes of a Collection.
+type IndexView struct {
+	view mongo.IndexView
+}
+
+func newIndexView(coll *mongo.Collection) *IndexView {
+	return &IndexView{view: coll.Indexes()}
+}
+
+// Indexes returns an IndexView for managing the indexes of the collection.
+func (c *Collection) Indexes() *IndexView {
+	return newIndexView(c.coll)
+}
+
+// CreateOne creates a single index as described by model, returning its name.
+func (iv *IndexView) CreateOne(ctx context.Context, model IndexModel) (string, error) {
+	driverModel, err := model.toDriverModel()
+	if err != nil {
+		return "", err
+	}
+	return iv.view.CreateOne(ctx, driverModel)
+}
+
+// CreateMany creates multiple indexes, returning their names in the same
+// order as models.
+func (iv *IndexView) CreateMany(ctx context.Context, models []IndexModel) ([]string, error) {
+	driverModels := make([]mongo.IndexModel, len(models))
+	for i, model := range models {
+		driverModel, err := model.toDriverModel()
+		if err != nil {
+			return nil, err
+		}
+		driverModels[i] = driverModel
+	}
+	return iv.view.CreateMany(ctx, driverModels)
+}
+
+// List returns the specifications of all indexes on the collection.
+func (iv *IndexView) List(ctx context.Context) ([]bson.M, error) {
+	cursor, err := iv.view.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []bson.M
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// DropOne drops the index with the given name.
+func (iv *IndexView) DropOne(ctx context.Context, name string) error {
+	_, err := iv.view.DropOne(ctx, name)
+	return err
+}
+
+// DropAll drops all indexes on the collection except the default index on _id.
+func (iv *IndexView) DropAll(ctx context.Context) error {
+	_, err := iv.view.DropAll(ctx)
+	return err
+}
+
+// EnsureIndexes creates whichever of models do not already exist on the
+// collection, matched by name (IndexModel.Name, or MongoDB's generated name
+// when empty), leaving existing indexes untouched. It is meant to be called
+// at application startup to converge a collection's indexes on the desired
+// set without recreating ones that are already there.
+func (iv *IndexView) EnsureIndexes(ctx context.Context, models []IndexModel) error {
+	existing, err := iv.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	existingNames := make(map[string]bool, len(existing))
+	for _, idx := range existing {
+		if name, ok := idx["name"].(string); ok {
+			existingNames[name] = true
+		}
+	}
+
+	var missing []IndexModel
+	for _, model := range models {
+		name, err := model.name()
+		if err != nil {
+			return err
+		}
+		if !existingNames[name] {
+			missing = append(missing, model)
+		}
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	_, err = iv.CreateMany(ctx, missing)
+	return err
+}
@@ -0,0 +1,27 @@
+package hongo_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hotmall/hongo"
+)
+
+func TestEnsureIndexes(t *testing.T) {
+	skipIfNoMongo(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	idx := hongo.Use("test").Collection("neighborhoods").Indexes()
+	err := idx.EnsureIndexes(ctx, []hongo.IndexModel{
+		{Keys: `{"geometry": "2dsphere"}`},
+		{Keys: `{"name": 1}`, Unique: true},
+	})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+}
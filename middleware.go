@@ -0,0 +1,122 @@
+package hongo
+
+import (
+	"context"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// OpName identifies the Collection method a middleware chain is running for.
+type OpName string
+
+const (
+	OpAggregate              OpName = "aggregate"
+	OpAggregateStream        OpName = "aggregateStream"
+	OpCountDocuments         OpName = "countDocuments"
+	OpDeleteMany             OpName = "deleteMany"
+	OpDeleteOne              OpName = "deleteOne"
+	OpDistinct               OpName = "distinct"
+	OpDrop                   OpName = "drop"
+	OpEstimatedDocumentCount OpName = "estimatedDocumentCount"
+	OpFind                   OpName = "find"
+	OpFindOne                OpName = "findOne"
+	OpFindOneAndDelete       OpName = "findOneAndDelete"
+	OpFindOneAndReplace      OpName = "findOneAndReplace"
+	OpFindOneAndUpdate       OpName = "findOneAndUpdate"
+	OpInsertMany             OpName = "insertMany"
+	OpInsertOne              OpName = "insertOne"
+	OpPaginateFind           OpName = "paginateFind"
+	OpReplaceOne             OpName = "replaceOne"
+	OpUpdateByID             OpName = "updateByID"
+	OpUpdateMany             OpName = "updateMany"
+	OpUpdateOne              OpName = "updateOne"
+)
+
+// OpContext describes a single Collection operation as it flows through the
+// middleware pipeline. Middleware may read or rewrite Filter/Update/Documents
+// before calling next, and may inspect or replace Result after. The
+// operation's error is the value returned by next; hongo itself neither sets
+// nor reads the Err field.
+type OpContext struct {
+	Context    context.Context
+	Op         OpName
+	Database   string
+	Collection string
+
+	Filter    bson.M
+	Update    bson.M
+	Documents []interface{}
+	Options   interface{}
+
+	// PageSize is the page size requested of OpPaginateFind; it is zero for
+	// every other Op.
+	PageSize int64
+
+	Result interface{}
+	Err    error
+}
+
+// Handler executes (or continues executing) a single operation described by
+// an OpContext.
+type Handler func(opCtx *OpContext) error
+
+// Middleware wraps a Handler with additional behavior, such as logging,
+// tracing, or rewriting the operation before it runs. Middleware may
+// short-circuit the chain by returning without calling next.
+type Middleware func(next Handler) Handler
+
+var (
+	globalMiddlewareMu sync.RWMutex
+	globalMiddleware   []Middleware
+)
+
+// UseMiddleware registers middleware that runs for every Collection, ahead of
+// any middleware registered on a specific Collection via (*Collection).Use.
+func UseMiddleware(mw ...Middleware) {
+	globalMiddlewareMu.Lock()
+	defer globalMiddlewareMu.Unlock()
+	globalMiddleware = append(globalMiddleware, mw...)
+}
+
+// Use registers middleware that runs for every operation on c, ahead of any
+// middleware registered later on c.
+func (c *Collection) Use(mw ...Middleware) *Collection {
+	c.mw = append(c.mw, mw...)
+	return c
+}
+
+// chain builds the Handler for a single operation by wrapping terminal with
+// the global middleware followed by c's own middleware, innermost last.
+func (c *Collection) chain(terminal Handler) Handler {
+	globalMiddlewareMu.RLock()
+	mws := make([]Middleware, 0, len(globalMiddleware)+len(c.mw))
+	mws = append(mws, globalMiddleware...)
+	globalMiddlewareMu.RUnlock()
+	mws = append(mws, c.mw...)
+
+	h := terminal
+	for i := len(mws) - 1; i >= 0; i-- {
+		h = mws[i](h)
+	}
+	return h
+}
+
+// run executes op through c's middleware chain, populating opCtx.Op,
+// opCtx.Database, opCtx.Collection and opCtx.Context before dispatching to
+// terminal.
+func (c *Collection) run(ctx context.Context, op OpName, opCtx *OpContext, terminal Handler) error {
+	opCtx.Context = ctx
+	opCtx.Op = op
+	opCtx.Database = c.coll.Database().Name()
+	opCtx.Collection = c.coll.Name()
+	return c.chain(terminal)(opCtx)
+}
+
+// resultAs extracts opCtx.Result as T, returning the zero value if Result is
+// nil or holds a different type (as can happen when middleware short-circuits
+// the chain without setting Result).
+func resultAs[T any](opCtx *OpContext) T {
+	v, _ := opCtx.Result.(T)
+	return v
+}
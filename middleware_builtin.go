@@ -0,0 +1,76 @@
+package hongo
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+const defaultSlowThreshold = 500 * time.Millisecond
+
+var (
+	slowThresholdMu sync.RWMutex
+	slowThreshold   = defaultSlowThreshold
+)
+
+// SetSlowThreshold sets the duration at or above which SlowLogMiddleware logs an
+// operation. The default is 500ms.
+func SetSlowThreshold(d time.Duration) {
+	slowThresholdMu.Lock()
+	defer slowThresholdMu.Unlock()
+	slowThreshold = d
+}
+
+func getSlowThreshold() time.Duration {
+	slowThresholdMu.RLock()
+	defer slowThresholdMu.RUnlock()
+	return slowThreshold
+}
+
+// SlowLogMiddleware logs any operation whose duration meets or exceeds the
+// threshold configured via SetSlowThreshold, along with its op name,
+// collection, filter and duration.
+func SlowLogMiddleware() Middleware {
+	return func(next Handler) Handler {
+		return func(opCtx *OpContext) error {
+			start := time.Now()
+			err := next(opCtx)
+			if d := time.Since(start); d >= getSlowThreshold() {
+				log.Printf("[hongo] slow op: op=%s collection=%s duration=%s filter=%v",
+					opCtx.Op, opCtx.Collection, d, opCtx.Filter)
+			}
+			return err
+		}
+	}
+}
+
+// OTelMiddleware starts a span named "hongo.<op>" for every operation,
+// tagging it with the collection name and recording any error returned by the
+// operation. tracerName is passed to otel.Tracer and should typically be the
+// importing application's module path.
+func OTelMiddleware(tracerName string) Middleware {
+	tracer := otel.Tracer(tracerName)
+	return func(next Handler) Handler {
+		return func(opCtx *OpContext) error {
+			ctx, span := tracer.Start(opCtx.Context, "hongo."+string(opCtx.Op))
+			defer span.End()
+
+			span.SetAttributes(
+				attribute.String("db.mongodb.collection", opCtx.Collection),
+				attribute.String("db.operation", string(opCtx.Op)),
+			)
+
+			opCtx.Context = ctx
+			err := next(opCtx)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			return err
+		}
+	}
+}
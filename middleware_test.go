@@ -0,0 +1,40 @@
+package hongo
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCollectionUseOrdering(t *testing.T) {
+	var order []string
+
+	mw := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(opCtx *OpContext) error {
+				order = append(order, name)
+				return next(opCtx)
+			}
+		}
+	}
+
+	c := &Collection{}
+	c.Use(mw("first"), mw("second"))
+
+	err := c.chain(func(opCtx *OpContext) error {
+		order = append(order, "terminal")
+		return nil
+	})(&OpContext{Context: context.Background()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"first", "second", "terminal"}
+	if len(order) != len(want) {
+		t.Fatalf("got %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("got %v, want %v", order, want)
+		}
+	}
+}
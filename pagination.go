@@ -0,0 +1,146 @@
+package hongo
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ErrInvalidSort is returned by PaginateFind when sort does not specify
+// exactly one field, or when that field's order is not 1 or -1.
+var ErrInvalidSort = errors.New("hongo: sort must be a JSON object with exactly one field")
+
+// paginationToken is the opaque cursor handed back by PaginateFind. It is
+// round-tripped through BSON extended JSON rather than encoding/json so that
+// typed sort values (ObjectIDs, dates, ...) survive the trip unchanged.
+type paginationToken struct {
+	SortValue interface{} `bson:"v"`
+	LastID    interface{} `bson:"id"`
+}
+
+func encodeToken(tok paginationToken) (string, error) {
+	b, err := bson.MarshalExtJSON(tok, true, false)
+	if err != nil {
+		return "", err
+	}
+	return base64.URLEncoding.EncodeToString(b), nil
+}
+
+func decodeToken(token string) (paginationToken, error) {
+	var tok paginationToken
+	b, err := base64.URLEncoding.DecodeString(token)
+	if err != nil {
+		return tok, err
+	}
+	err = bson.UnmarshalExtJSON(b, true, &tok)
+	return tok, err
+}
+
+// PaginateFind executes a find command ordered by sort and returns one page of
+// up to pageSize results together with an opaque token for fetching the next
+// page. Pagination is keyset-based (a range filter derived from the last
+// document's sort value and _id, rather than skip), so deep pages cost the
+// same as shallow ones.
+//
+// filter and sort are JSON documents in the same style as Find; sort must name
+// exactly one field with a MongoDB sort order, e.g. `{"createdAt": 1}`. token
+// must be "" for the first page and the nextToken from the previous call for
+// subsequent pages. nextToken is "" when the page holds fewer than pageSize
+// results. A final page that holds exactly pageSize results still carries a
+// nextToken; the call made with it returns no results and an empty nextToken.
+func (c *Collection) PaginateFind(ctx context.Context, filter string, sort string, pageSize int64,
+	token string) (results []bson.M, nextToken string, err error) {
+
+	var f bson.M
+	if err := json.Unmarshal([]byte(filter), &f); err != nil {
+		return nil, "", err
+	}
+
+	sortField, sortOrder, err := parseSingleFieldSort(sort)
+	if err != nil {
+		return nil, "", err
+	}
+
+	cmp := "$gt"
+	if sortOrder < 0 {
+		cmp = "$lt"
+	}
+
+	if token != "" {
+		tok, err := decodeToken(token)
+		if err != nil {
+			return nil, "", err
+		}
+
+		f = bson.M{"$and": bson.A{
+			f,
+			bson.M{"$or": bson.A{
+				bson.M{sortField: bson.M{cmp: tok.SortValue}},
+				bson.M{
+					sortField: tok.SortValue,
+					"_id":     bson.M{cmp: tok.LastID},
+				},
+			}},
+		}}
+	}
+
+	findOpts := options.Find().
+		SetSort(bson.D{{Key: sortField, Value: sortOrder}, {Key: "_id", Value: sortOrder}}).
+		SetLimit(pageSize)
+
+	opCtx := &OpContext{Filter: f, Options: []*options.FindOptions{findOpts}, PageSize: pageSize}
+	runErr := c.run(ctx, OpPaginateFind, opCtx, func(opCtx *OpContext) error {
+		cursor, err := c.coll.Find(opCtx.Context, opCtx.Filter, findOpts)
+		if err != nil {
+			return err
+		}
+
+		var page []bson.M
+		if err := cursor.All(opCtx.Context, &page); err != nil {
+			return err
+		}
+
+		opCtx.Result = page
+		return nil
+	})
+	if runErr != nil {
+		return nil, "", runErr
+	}
+
+	results = resultAs[[]bson.M](opCtx)
+	if int64(len(results)) == pageSize && pageSize > 0 {
+		last := results[len(results)-1]
+		nextToken, err = encodeToken(paginationToken{SortValue: last[sortField], LastID: last["_id"]})
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	return results, nextToken, nil
+}
+
+// parseSingleFieldSort decodes a JSON object with exactly one field, e.g.
+// `{"createdAt": -1}`, into its field name and MongoDB sort order (1 or -1).
+func parseSingleFieldSort(sort string) (string, int, error) {
+	var s bson.M
+	if err := json.Unmarshal([]byte(sort), &s); err != nil {
+		return "", 0, err
+	}
+	if len(s) != 1 {
+		return "", 0, ErrInvalidSort
+	}
+
+	for field, v := range s {
+		order, ok := v.(float64)
+		if !ok || (order != 1 && order != -1) {
+			return "", 0, ErrInvalidSort
+		}
+		return field, int(order), nil
+	}
+
+	return "", 0, ErrInvalidSort
+}
@@ -0,0 +1,46 @@
+package hongo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Session wraps a mongo.SessionContext so it can be passed as the ctx argument
+// to Collection methods, causing them to run as part of the surrounding
+// transaction instead of outside it.
+type Session struct {
+	mongo.SessionContext
+}
+
+// WithTransaction runs fn inside a transaction on the client registered as
+// DefaultClientName (see Connect/Register), delegating to the driver's
+// session.WithTransaction for its standard transaction retry loop: the whole
+// transaction is retried when fn or the commit fails with an error labeled
+// TransientTransactionError, and just the commit is retried when it fails
+// with UnknownTransactionCommitResult, all bounded by the driver's 120s
+// retry timeout and the caller's ctx.
+//
+// fn receives a *Session in place of a plain context.Context; pass it as ctx
+// to Collection methods to include them in the transaction.
+func WithTransaction(ctx context.Context, fn func(sessCtx *Session) (interface{}, error),
+	opts ...*options.TransactionOptions) (interface{}, error) {
+
+	clientsMu.RLock()
+	client, ok := clients[DefaultClientName]
+	clientsMu.RUnlock()
+	if !ok {
+		return nil, ErrNotConnected
+	}
+
+	session, err := client.StartSession()
+	if err != nil {
+		return nil, err
+	}
+	defer session.EndSession(ctx)
+
+	return session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		return fn(&Session{SessionContext: sessCtx})
+	}, opts...)
+}
@@ -0,0 +1,30 @@
+package hongo_test
+
+import (
+	"context"
+
+	"github.com/hotmall/hongo"
+)
+
+// ExampleWithTransaction shows how to update two collections atomically: a
+// bank transfer that debits one account and credits another in the same
+// transaction.
+func ExampleWithTransaction() {
+	ctx := context.Background()
+
+	accounts := hongo.Use("bank").Collection("accounts")
+	ledger := hongo.Use("bank").Collection("ledger")
+
+	_, err := hongo.WithTransaction(ctx, func(sessCtx *hongo.Session) (interface{}, error) {
+		if _, err := accounts.UpdateOne(sessCtx, `{"_id": "alice"}`, `{"$inc": {"balance": -100}}`); err != nil {
+			return nil, err
+		}
+		if _, err := accounts.UpdateOne(sessCtx, `{"_id": "bob"}`, `{"$inc": {"balance": 100}}`); err != nil {
+			return nil, err
+		}
+		return ledger.InsertOne(sessCtx, `{"from": "alice", "to": "bob", "amount": 100}`)
+	})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,142 @@
+package hongo
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// TypedCollection is a generics-based wrapper around a Collection. Unlike
+// Collection, it decodes results directly into T and accepts bson.M/structs for
+// filters, avoiding the JSON-string round-trip callers with a concrete type would
+// otherwise have to pay. It runs every method through the same middleware
+// chain as Collection, so built-ins like slow-query logging and OTel tracing
+// see typed operations too.
+type TypedCollection[T any] struct {
+	coll *Collection
+}
+
+// Typed returns a TypedCollection bound to the named collection in db.
+func Typed[T any](db *Database, name string, opts ...*options.CollectionOptions) *TypedCollection[T] {
+	return &TypedCollection[T]{
+		coll: db.Collection(name, opts...),
+	}
+}
+
+// FindOne executes a find command and decodes the first matching document into T.
+//
+// If the filter does not match any documents, the zero value of T is returned
+// along with mongo.ErrNoDocuments.
+func (c *TypedCollection[T]) FindOne(ctx context.Context, filter bson.M, opts ...*options.FindOneOptions) (T, error) {
+	opCtx := &OpContext{Filter: filter, Options: opts}
+	err := c.coll.run(ctx, OpFindOne, opCtx, func(opCtx *OpContext) error {
+		var v T
+		err := c.coll.coll.FindOne(opCtx.Context, opCtx.Filter, opts...).Decode(&v)
+		opCtx.Result = v
+		return err
+	})
+	return resultAs[T](opCtx), err
+}
+
+// FindOneOpt is like FindOne but returns a nil pointer instead of an error when
+// the filter does not match any documents.
+func (c *TypedCollection[T]) FindOneOpt(ctx context.Context, filter bson.M, opts ...*options.FindOneOptions) (*T, error) {
+	v, err := c.FindOne(ctx, filter, opts...)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// FindOneByID is equivalent to FindOne(ctx, bson.M{"_id": id}, opts...).
+func (c *TypedCollection[T]) FindOneByID(ctx context.Context, id interface{}, opts ...*options.FindOneOptions) (T, error) {
+	return c.FindOne(ctx, bson.M{"_id": id}, opts...)
+}
+
+// List executes a find command and decodes all matching documents into a []T.
+func (c *TypedCollection[T]) List(ctx context.Context, filter bson.M, opts ...*options.FindOptions) ([]T, error) {
+	opCtx := &OpContext{Filter: filter, Options: opts}
+	err := c.coll.run(ctx, OpFind, opCtx, func(opCtx *OpContext) error {
+		cursor, err := c.coll.coll.Find(opCtx.Context, opCtx.Filter, opts...)
+		if err != nil {
+			return err
+		}
+
+		var results []T
+		if err := cursor.All(opCtx.Context, &results); err != nil {
+			return err
+		}
+
+		opCtx.Result = results
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resultAs[[]T](opCtx), nil
+}
+
+// InsertOne inserts document into the collection and returns its _id.
+func (c *TypedCollection[T]) InsertOne(ctx context.Context, document T, opts ...*options.InsertOneOptions) (interface{}, error) {
+	opCtx := &OpContext{Documents: []interface{}{document}, Options: opts}
+	err := c.coll.run(ctx, OpInsertOne, opCtx, func(opCtx *OpContext) error {
+		result, err := c.coll.coll.InsertOne(opCtx.Context, opCtx.Documents[0], opts...)
+		opCtx.Result = result
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resultAs[*mongo.InsertOneResult](opCtx).InsertedID, nil
+}
+
+// UpdateOneByID applies document's fields onto the document whose _id matches
+// id via a $set update, leaving fields not present in document untouched.
+func (c *TypedCollection[T]) UpdateOneByID(ctx context.Context, id interface{}, document T, opts ...*options.UpdateOptions) error {
+	opCtx := &OpContext{Filter: bson.M{"_id": id}, Update: bson.M{"$set": document}, Options: opts}
+	return c.coll.run(ctx, OpUpdateByID, opCtx, func(opCtx *OpContext) error {
+		_, err := c.coll.coll.UpdateOne(opCtx.Context, opCtx.Filter, opCtx.Update, opts...)
+		return err
+	})
+}
+
+// ReplaceOneByID replaces the document whose _id matches id with document in its
+// entirety.
+func (c *TypedCollection[T]) ReplaceOneByID(ctx context.Context, id interface{}, document T, opts ...*options.ReplaceOptions) error {
+	opCtx := &OpContext{Filter: bson.M{"_id": id}, Documents: []interface{}{document}, Options: opts}
+	return c.coll.run(ctx, OpReplaceOne, opCtx, func(opCtx *OpContext) error {
+		_, err := c.coll.coll.ReplaceOne(opCtx.Context, opCtx.Filter, opCtx.Documents[0], opts...)
+		return err
+	})
+}
+
+// Iterate executes a find command and invokes fn with each matching document
+// decoded into T, stopping at the first error returned by fn or the cursor.
+func (c *TypedCollection[T]) Iterate(ctx context.Context, filter bson.M, fn func(T) error, opts ...*options.FindOptions) error {
+	opCtx := &OpContext{Filter: filter, Options: opts}
+	return c.coll.run(ctx, OpFind, opCtx, func(opCtx *OpContext) error {
+		cursor, err := c.coll.coll.Find(opCtx.Context, opCtx.Filter, opts...)
+		if err != nil {
+			return err
+		}
+		defer cursor.Close(opCtx.Context)
+
+		for cursor.Next(opCtx.Context) {
+			var v T
+			if err := cursor.Decode(&v); err != nil {
+				return err
+			}
+			if err := fn(v); err != nil {
+				return err
+			}
+		}
+
+		return cursor.Err()
+	})
+}
@@ -0,0 +1,31 @@
+package hongo_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hotmall/hongo"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type neighborhood struct {
+	ID   interface{} `bson:"_id"`
+	Name string      `bson:"name"`
+}
+
+func TestTypedFindOne(t *testing.T) {
+	skipIfNoMongo(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	coll := hongo.Typed[neighborhood](hongo.Use("test"), "neighborhoods")
+	v, err := coll.FindOne(ctx, bson.M{"name": "Bushwick"})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(v)
+}